@@ -43,6 +43,7 @@ import (
 	otelmetrics "go.opentelemetry.io/ebpf-profiler/metrics"
 	"go.opentelemetry.io/ebpf-profiler/reporter"
 	"go.opentelemetry.io/ebpf-profiler/reporter/samples"
+	"go.opentelemetry.io/ebpf-profiler/support"
 )
 
 // Assert that we implement the full Reporter interface.
@@ -202,7 +203,25 @@ func (r *ParcaReporter) ReportTraceEvent(trace *libpf.Trace,
 	trace.Hash.PutBytes16(&buf)
 	r.sampleWriter.StacktraceID.Append(buf[:])
 
-	r.sampleWriter.Value.Append(1)
+	if meta.Origin == support.TraceOriginOffCPU {
+		// Off-CPU samples carry the time the thread spent off the CPU, so
+		// they are reported as their own profile type.
+		r.sampleWriter.Value.Append(meta.OffTime)
+		r.sampleWriter.SampleType.AppendString("wallclock")
+		r.sampleWriter.SampleUnit.AppendString("nanoseconds")
+		r.sampleWriter.PeriodType.AppendString("samples")
+		r.sampleWriter.PeriodUnit.AppendString("count")
+		r.sampleWriter.Period.Append(1)
+	} else {
+		r.sampleWriter.Value.Append(1)
+		r.sampleWriter.SampleType.AppendString("samples")
+		r.sampleWriter.SampleUnit.AppendString("count")
+		r.sampleWriter.PeriodType.AppendString("cpu")
+		r.sampleWriter.PeriodUnit.AppendString("nanoseconds")
+		// Since the period is of type cpu nanoseconds it is the time between
+		// samples.
+		r.sampleWriter.Period.Append(1e9 / r.samplesPerSecond)
+	}
 	r.sampleWriter.Timestamp.Append(int64(meta.Timestamp))
 }
 
@@ -1047,20 +1066,8 @@ func (r *ParcaReporter) buildSampleRecord(ctx context.Context) (arrow.Record, in
 	r.writeCommonLabels(w, rows)
 	w.Producer.ree.Append(rows)
 	w.Producer.bd.AppendString("parca_agent")
-	w.SampleType.ree.Append(rows)
-	w.SampleType.bd.AppendString("samples")
-	w.SampleUnit.ree.Append(rows)
-	w.SampleUnit.bd.AppendString("count")
-	w.PeriodType.ree.Append(rows)
-	w.PeriodType.bd.AppendString("cpu")
-	w.PeriodUnit.ree.Append(rows)
-	w.PeriodUnit.bd.AppendString("nanoseconds")
 	w.Temporality.ree.Append(rows)
 	w.Temporality.bd.AppendString("delta")
-	w.Period.ree.Append(rows)
-	// Since the period is of type cpu nanoseconds it is the time between
-	// samples.
-	w.Period.ib.Append(1e9 / int64(r.samplesPerSecond))
 	w.Duration.ree.Append(rows)
 	w.Duration.ib.Append(time.Second.Nanoseconds())
 